@@ -0,0 +1,211 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/feast-dev/feast/go/protos/feast/core"
+	"google.golang.org/protobuf/proto"
+)
+
+// RegistryConfig holds the subset of feature_store.yaml registry settings relevant to
+// a remote, HTTP-served registry.
+type RegistryConfig struct {
+	Path     string
+	ClientId string
+}
+
+// HttpRegistryStore reads the protobuf-serialized Feast registry from a remote HTTP
+// endpoint, such as a registry server fronting object storage.
+type HttpRegistryStore struct {
+	project  string
+	endpoint string
+	clientId string
+	client   http.Client
+
+	// LoadProtobufMessages performs the HTTP fetch of url and invokes messageProcessor
+	// with the raw response body. It is a field rather than a plain method so tests can
+	// substitute a fake transport without standing up an HTTP server.
+	LoadProtobufMessages func(ctx context.Context, url string, messageProcessor func([]byte) error) error
+}
+
+// NewHttpRegistryStore validates connectivity to config.Path and returns a
+// HttpRegistryStore that fetches the registry from it on every Load* call.
+func NewHttpRegistryStore(config *RegistryConfig, project string) (*HttpRegistryStore, error) {
+	store := &HttpRegistryStore{
+		project:  project,
+		endpoint: config.Path,
+		clientId: config.ClientId,
+		client:   http.Client{},
+	}
+	store.LoadProtobufMessages = store.loadProtobufMessages
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, store.endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid registry endpoint %q: %w", store.endpoint, err)
+	}
+	if _, err := store.client.Do(req); err != nil {
+		return nil, fmt.Errorf("could not reach registry endpoint %q: %w", store.endpoint, err)
+	}
+
+	return store, nil
+}
+
+// loadProtobufMessages fetches url with ctx threaded through the request and the
+// response body read, so a canceled or timed-out ctx aborts the fetch mid-stream
+// instead of blocking the caller until the server eventually responds.
+func (r *HttpRegistryStore) loadProtobufMessages(ctx context.Context, url string, messageProcessor func([]byte) error) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := readAllWithDeadline(ctx, url, resp.Body)
+	if err != nil {
+		return err
+	}
+
+	return messageProcessor(body)
+}
+
+// readAllWithDeadline reads r to completion, but abandons the read as soon as ctx is
+// done, releasing any partially consumed buffer instead of waiting on a wedged stream.
+func readAllWithDeadline(ctx context.Context, url string, r io.Reader) ([]byte, error) {
+	timer := newDeadlineTimer()
+	if deadline, ok := ctx.Deadline(); ok {
+		timer.SetReadDeadline(deadline)
+	}
+
+	type result struct {
+		body []byte
+		err  error
+	}
+	resultCh := make(chan result, 1)
+	go func() {
+		body, err := io.ReadAll(r)
+		resultCh <- result{body, err}
+	}()
+
+	select {
+	case res := <-resultCh:
+		return res.body, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timer.readDeadlineCh():
+		return nil, fmt.Errorf("timed out reading registry response from %s", url)
+	}
+}
+
+// deadlineTimer models paired read/write deadlines as cancel channels that are closed
+// by time.AfterFunc, mirroring the pattern used by net.Conn adapters: each
+// SetReadDeadline/SetWriteDeadline call replaces the previous timer so resetting a
+// deadline mid-stream is safe to call repeatedly.
+type deadlineTimer struct {
+	mu        sync.Mutex
+	readCh    chan struct{}
+	writeCh   chan struct{}
+	readTimer *time.Timer
+	writeTmr  *time.Timer
+}
+
+func newDeadlineTimer() *deadlineTimer {
+	return &deadlineTimer{
+		readCh:  make(chan struct{}),
+		writeCh: make(chan struct{}),
+	}
+}
+
+func (d *deadlineTimer) SetDeadline(t time.Time) {
+	d.SetReadDeadline(t)
+	d.SetWriteDeadline(t)
+}
+
+func (d *deadlineTimer) SetReadDeadline(t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.readTimer != nil {
+		d.readTimer.Stop()
+	}
+	d.readCh = make(chan struct{})
+	if t.IsZero() {
+		return
+	}
+	ch := d.readCh
+	d.readTimer = time.AfterFunc(time.Until(t), func() { close(ch) })
+}
+
+func (d *deadlineTimer) SetWriteDeadline(t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.writeTmr != nil {
+		d.writeTmr.Stop()
+	}
+	d.writeCh = make(chan struct{})
+	if t.IsZero() {
+		return
+	}
+	ch := d.writeCh
+	d.writeTmr = time.AfterFunc(time.Until(t), func() { close(ch) })
+}
+
+func (d *deadlineTimer) readDeadlineCh() <-chan struct{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.readCh
+}
+
+func (d *deadlineTimer) writeDeadlineCh() <-chan struct{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.writeCh
+}
+
+func (r *HttpRegistryStore) LoadEntities(ctx context.Context, registry *core.Registry) error {
+	return r.load(ctx, "entities", func(data []byte) error {
+		entityList := &core.EntityList{}
+		if err := proto.Unmarshal(data, entityList); err != nil {
+			return err
+		}
+		registry.Entities = entityList.Entities
+		return nil
+	})
+}
+
+func (r *HttpRegistryStore) LoadFeatureViews(ctx context.Context, registry *core.Registry) error {
+	return r.load(ctx, "feature_views", func(data []byte) error {
+		fvList := &core.FeatureViewList{}
+		if err := proto.Unmarshal(data, fvList); err != nil {
+			return err
+		}
+		registry.FeatureViews = fvList.FeatureViews
+		return nil
+	})
+}
+
+func (r *HttpRegistryStore) LoadOnDemandFeatureViews(ctx context.Context, registry *core.Registry) error {
+	return r.load(ctx, "on_demand_feature_views", func(data []byte) error {
+		odfvList := &core.OnDemandFeatureViewList{}
+		if err := proto.Unmarshal(data, odfvList); err != nil {
+			return err
+		}
+		registry.OnDemandFeatureViews = odfvList.OnDemandFeatureViews
+		return nil
+	})
+}
+
+func (r *HttpRegistryStore) load(ctx context.Context, resource string, messageProcessor func([]byte) error) error {
+	url := fmt.Sprintf("%s/%s", r.endpoint, resource)
+	return r.LoadProtobufMessages(ctx, url, messageProcessor)
+}
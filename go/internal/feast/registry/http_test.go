@@ -1,6 +1,8 @@
 package registry
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -17,21 +19,21 @@ func TestNewHttpRegistryStore(t *testing.T) {
 	defer mockServer.Close()
 
 	// Configure the test
-	config := &registry.RegistryConfig{
+	config := &RegistryConfig{
 		Path:     mockServer.URL,
 		ClientId: "test-client",
 	}
 	project := "test-project"
 
 	// Test NewHttpRegistryStore with a valid configuration
-	_, err := registry.NewHttpRegistryStore(config, project)
+	_, err := NewHttpRegistryStore(config, project)
 	if err != nil {
 		t.Errorf("Expected no error, but got: %v", err)
 	}
 
 	// Test NewHttpRegistryStore with an invalid configuration (simulating connection error)
 	config.Path = "invalid-url"
-	_, err = registry.NewHttpRegistryStore(config, project)
+	_, err = NewHttpRegistryStore(config, project)
 	if err == nil {
 		t.Error("Expected an error, but got nil")
 	}
@@ -46,7 +48,7 @@ func TestHttpRegistryStore_LoadEntities(t *testing.T) {
 	defer mockServer.Close()
 
 	// Create HttpRegistryStore with mock server configuration
-	hrs := &registry.HttpRegistryStore{
+	hrs := &HttpRegistryStore{
 		project:  "test-project",
 		endpoint: mockServer.URL,
 		clientId: "test-client",
@@ -64,13 +66,13 @@ func TestHttpRegistryStore_LoadEntities(t *testing.T) {
 	}
 
 	// Mock loadProtobufMessages
-	hrs.LoadProtobufMessages = func(url string, messageProcessor func([]byte) error) error {
+	hrs.LoadProtobufMessages = func(ctx context.Context, url string, messageProcessor func([]byte) error) error {
 		return messageProcessor(mockData)
 	}
 
 	// Test LoadEntities
 	registry := &core.Registry{}
-	err = hrs.LoadEntities(registry)
+	err = hrs.LoadEntities(context.Background(), registry)
 	if err != nil {
 		t.Errorf("Expected no error, but got: %v", err)
 	}
@@ -80,3 +82,27 @@ func TestHttpRegistryStore_LoadEntities(t *testing.T) {
 		t.Errorf("Expected %d entities, but got %d", len(mockResponse.Entities), len(registry.Entities))
 	}
 }
+
+// TestHttpRegistryStore_LoadEntitiesRespectsContextCancellation exercises the real
+// public Load* API end-to-end (not loadProtobufMessages/load directly) to prove a
+// canceled context actually aborts the fetch instead of being synthesized away as
+// context.Background() internally.
+func TestHttpRegistryStore_LoadEntitiesRespectsContextCancellation(t *testing.T) {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer mockServer.Close()
+
+	hrs, err := NewHttpRegistryStore(&RegistryConfig{Path: mockServer.URL, ClientId: "test-client"}, "test-project")
+	if err != nil {
+		t.Fatalf("Failed to construct HttpRegistryStore: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = hrs.LoadEntities(ctx, &core.Registry{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, but got: %v", err)
+	}
+}
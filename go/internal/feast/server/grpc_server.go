@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/feast-dev/feast/go/internal/feast"
+	"github.com/feast-dev/feast/go/internal/feast/model"
+	"github.com/feast-dev/feast/go/internal/feast/onlineserving"
+	"github.com/feast-dev/feast/go/protos/feast/serving"
+	"github.com/feast-dev/feast/go/types"
+	"google.golang.org/grpc"
+)
+
+// GrpcServer serves the Feast ServingService protobuf API, matching the Python Feast
+// gRPC surface, backed by the same feast.FeatureStore that HttpServer fronts with JSON.
+type GrpcServer struct {
+	serving.UnimplementedServingServiceServer
+	fs *feast.FeatureStore
+}
+
+// NewGrpcServer returns a GrpcServer backed by fs.
+func NewGrpcServer(fs *feast.FeatureStore) *GrpcServer {
+	return &GrpcServer{fs: fs}
+}
+
+// Serve blocks, handling ServingService RPCs on host:port.
+func (g *GrpcServer) Serve(host string, port int) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", host, port))
+	if err != nil {
+		return err
+	}
+	grpcServer := grpc.NewServer()
+	serving.RegisterServingServiceServer(grpcServer, g)
+	return grpcServer.Serve(listener)
+}
+
+// GetOnlineFeatures implements the ServingService RPC. HttpServer.getOnlineFeatures is a
+// thin JSON transcoder over this same method, so request parsing only happens once.
+func (g *GrpcServer) GetOnlineFeatures(ctx context.Context, req *serving.GetOnlineFeaturesRequest) (*serving.GetOnlineFeaturesResponse, error) {
+	var featureNames []string
+	var featureService *model.FeatureService
+
+	switch kind := req.GetKind().(type) {
+	case *serving.GetOnlineFeaturesRequest_FeatureService:
+		resolved, err := g.fs.GetFeatureService(kind.FeatureService)
+		if err != nil {
+			return nil, err
+		}
+		featureService = resolved
+	default:
+		featureNames = req.GetFeatures().GetVal()
+	}
+
+	vectors, err := g.fs.GetOnlineFeatures(
+		ctx,
+		featureNames,
+		featureService,
+		req.GetEntities(),
+		req.GetRequestContext(),
+		req.GetFullFeatureNames(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return featureVectorsToGrpcResponse(vectors)
+}
+
+// featureVectorsToGrpcResponse projects []*onlineserving.FeatureVector into the
+// ServingService response shape. HttpServer.getOnlineFeatures reuses this same
+// projection so the HTTP and gRPC paths are guaranteed to describe the same response.
+func featureVectorsToGrpcResponse(vectors []*onlineserving.FeatureVector) (*serving.GetOnlineFeaturesResponse, error) {
+	names := make([]string, len(vectors))
+	results := make([]*serving.GetOnlineFeaturesResponse_FeatureVector, len(vectors))
+
+	for idx, vector := range vectors {
+		names[idx] = vector.Name
+
+		values, err := types.ArrowArrayToProtoValues(vector.Values)
+		if err != nil {
+			return nil, err
+		}
+
+		results[idx] = &serving.GetOnlineFeaturesResponse_FeatureVector{
+			Values:          values,
+			Statuses:        vector.Statuses,
+			EventTimestamps: vector.Timestamps,
+		}
+	}
+
+	return &serving.GetOnlineFeaturesResponse{
+		Metadata: &serving.GetOnlineFeaturesResponseMetadata{FeatureNames: &serving.FeatureList{Val: names}},
+		Results:  results,
+	}, nil
+}
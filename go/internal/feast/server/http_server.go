@@ -0,0 +1,171 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/feast-dev/feast/go/internal/feast"
+	"github.com/feast-dev/feast/go/protos/feast/serving"
+	prototypes "github.com/feast-dev/feast/go/protos/feast/types"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// defaultMaxRecvSize caps a request body at 10MiB unless overridden with WithMaxRecvSize.
+	defaultMaxRecvSize = 10 << 20
+	// defaultRequestTimeout bounds how long a single getOnlineFeatures call may run unless
+	// overridden with WithRequestTimeout.
+	defaultRequestTimeout = 10 * time.Second
+	// defaultHttpTimeout bounds the underlying http.Server's read/write deadlines.
+	defaultHttpTimeout = 30 * time.Second
+)
+
+// HttpServer is a thin JSON transcoder in front of GrpcServer: it translates the
+// POST /get-online-features JSON body into a GetOnlineFeatures RPC and the RPC response
+// back to JSON, so request parsing lives in exactly one place for both protocols.
+type HttpServer struct {
+	grpc           *GrpcServer
+	loggingService *LoggingService
+	maxRecvSize    int64
+	requestTimeout time.Duration
+}
+
+// LoggingService, when set on a HttpServer, is invoked after every successfully served
+// request so served feature values can be shipped to an offline log store for
+// training-serving skew detection.
+type LoggingService struct {
+	Log func(ctx context.Context, request *getOnlineFeaturesRequest, response *serving.GetOnlineFeaturesResponse)
+}
+
+// Option configures optional HttpServer behavior beyond the required fs and
+// loggingService constructor arguments.
+type Option func(*HttpServer)
+
+// WithMaxRecvSize caps the size, in bytes, of a getOnlineFeatures request body. A client
+// that exceeds it gets a 413 instead of the server buffering an unbounded payload.
+func WithMaxRecvSize(maxRecvSize int64) Option {
+	return func(s *HttpServer) { s.maxRecvSize = maxRecvSize }
+}
+
+// WithRequestTimeout bounds how long a single getOnlineFeatures call, including the
+// underlying online store lookups, may run before the server aborts it with a 504.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(s *HttpServer) { s.requestTimeout = timeout }
+}
+
+// NewHttpServer returns a HttpServer that serves fs over HTTP. loggingService may be nil.
+func NewHttpServer(fs *feast.FeatureStore, loggingService *LoggingService, opts ...Option) *HttpServer {
+	s := &HttpServer{
+		grpc:           NewGrpcServer(fs),
+		loggingService: loggingService,
+		maxRecvSize:    defaultMaxRecvSize,
+		requestTimeout: defaultRequestTimeout,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Serve blocks, handling requests against the Feast HTTP API on host:port.
+func (s *HttpServer) Serve(host string, port int) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/get-online-features", s.getOnlineFeatures)
+	mux.HandleFunc("/get-online-features:stream", s.getOnlineFeaturesStream)
+	httpServer := &http.Server{
+		Addr:         fmt.Sprintf("%s:%d", host, port),
+		Handler:      mux,
+		ReadTimeout:  defaultHttpTimeout,
+		WriteTimeout: defaultHttpTimeout,
+	}
+	return httpServer.ListenAndServe()
+}
+
+// getOnlineFeaturesRequest mirrors the JSON body of Python Feast's
+// POST /get-online-features endpoint.
+type getOnlineFeaturesRequest struct {
+	FeatureService   *string                  `json:"feature_service"`
+	Features         []string                 `json:"features"`
+	Entities         map[string]repeatedValue `json:"entities"`
+	RequestContext   map[string]repeatedValue `json:"request_context"`
+	FullFeatureNames bool                     `json:"full_feature_names"`
+}
+
+// structuredError is the JSON body returned for request-level failures (oversize body,
+// deadline exceeded, malformed JSON), rather than plain-text http.Error output.
+type structuredError struct {
+	Error string `json:"error"`
+}
+
+func writeStructuredError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(structuredError{Error: err.Error()})
+}
+
+func (s *HttpServer) getOnlineFeatures(w http.ResponseWriter, req *http.Request) {
+	req.Body = http.MaxBytesReader(w, req.Body, s.maxRecvSize)
+
+	var request getOnlineFeaturesRequest
+	if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			writeStructuredError(w, http.StatusRequestEntityTooLarge, err)
+			return
+		}
+		writeStructuredError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if len(request.Features) == 0 && request.FeatureService == nil {
+		writeStructuredError(w, http.StatusBadRequest, errors.New("one of features or feature_service must be set"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), s.requestTimeout)
+	defer cancel()
+
+	rpcRequest := &serving.GetOnlineFeaturesRequest{
+		Kind:             &serving.GetOnlineFeaturesRequest_Features{Features: &serving.FeatureList{Val: request.Features}},
+		Entities:         toProtoMap(request.Entities),
+		RequestContext:   toProtoMap(request.RequestContext),
+		FullFeatureNames: request.FullFeatureNames,
+	}
+	if request.FeatureService != nil {
+		rpcRequest.Kind = &serving.GetOnlineFeaturesRequest_FeatureService{FeatureService: *request.FeatureService}
+	}
+
+	rpcResponse, err := s.grpc.GetOnlineFeatures(ctx, rpcRequest)
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			writeStructuredError(w, http.StatusGatewayTimeout, ctx.Err())
+			return
+		}
+		writeStructuredError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if s.loggingService != nil {
+		s.loggingService.Log(ctx, &request, rpcResponse)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	body, err := protojson.Marshal(rpcResponse)
+	if err != nil {
+		writeStructuredError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Write(body)
+}
+
+func toProtoMap(values map[string]repeatedValue) map[string]*prototypes.RepeatedValue {
+	result := make(map[string]*prototypes.RepeatedValue, len(values))
+	for name, value := range values {
+		result[name] = value.toProto()
+	}
+	return result
+}
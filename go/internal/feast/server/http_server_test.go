@@ -14,7 +14,9 @@ import (
 	"net/http/httptest"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestUnmarshalJSON(t *testing.T) {
@@ -55,7 +57,7 @@ func TestGetOnlineFeaturesWithValidRequest(t *testing.T) {
 	s := NewHttpServer(nil, nil)
 
 	config := getRepoConfig()
-	s.fs, _ = feast.NewFeatureStore(&config, nil)
+	s.grpc.fs, _ = feast.NewFeatureStore(&config, nil)
 	request := getOnlineFeaturesRequest{
 		Features: []string{"feature1", "feature2"},
 		Entities: map[string]repeatedValue{
@@ -74,6 +76,49 @@ func TestGetOnlineFeaturesWithValidRequest(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, rr.Code)
 }
 
+func TestGetOnlineFeaturesRejectsOversizedBody(t *testing.T) {
+	s := NewHttpServer(nil, nil, WithMaxRecvSize(16))
+
+	config := getRepoConfig()
+	s.grpc.fs, _ = feast.NewFeatureStore(&config, nil)
+	request := getOnlineFeaturesRequest{
+		Features: []string{"feature1", "feature2", "feature3", "feature4"},
+		Entities: map[string]repeatedValue{
+			"entity1": {int64Val: []int64{1, 2, 3}},
+		},
+	}
+
+	requestBody, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/get-online-features", bytes.NewBuffer(requestBody))
+	rr := httptest.NewRecorder()
+
+	s.getOnlineFeatures(rr, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rr.Code)
+}
+
+func TestGetOnlineFeaturesRequestTimeout(t *testing.T) {
+	s := NewHttpServer(nil, nil, WithRequestTimeout(time.Nanosecond))
+
+	config := getRepoConfig()
+	s.grpc.fs, _ = feast.NewFeatureStore(&config, nil)
+	request := getOnlineFeaturesRequest{
+		Features: []string{"feature1", "feature2"},
+		Entities: map[string]repeatedValue{
+			"entity1": {int64Val: []int64{1, 2, 3}},
+		},
+	}
+
+	requestBody, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/get-online-features", bytes.NewBuffer(requestBody))
+	rr := httptest.NewRecorder()
+
+	s.getOnlineFeatures(rr, req)
+
+	assert.Equal(t, http.StatusGatewayTimeout, rr.Code)
+	assert.True(t, strings.Contains(rr.Body.String(), "deadline"))
+}
+
 //func TestGetOnlineFeaturesWithInvalidJSON(t *testing.T) {
 //	s := NewHttpServer(nil, nil)
 //	config := getRepoConfig()
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	prototypes "github.com/feast-dev/feast/go/protos/feast/types"
+)
+
+// repeatedValue is a loosely-typed JSON array that UnmarshalJSON resolves into exactly
+// one of its typed fields, mirroring the oneof shape of feast/types.RepeatedValue: a flat
+// array of ints, doubles, strings, or bools, or a nested array of any of those.
+type repeatedValue struct {
+	int64Val  []int64
+	doubleVal []float64
+	stringVal []string
+	boolVal   []bool
+
+	int64ListVal  [][]int64
+	doubleListVal [][]float64
+	stringListVal [][]string
+	boolListVal   [][]bool
+}
+
+func (r *repeatedValue) UnmarshalJSON(data []byte) error {
+	var elements []json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	if len(elements) == 0 {
+		return nil
+	}
+
+	var firstAsArray []json.RawMessage
+	if err := json.Unmarshal(elements[0], &firstAsArray); err == nil {
+		return r.unmarshalNested(elements)
+	}
+
+	return r.unmarshalFlat(data)
+}
+
+func (r *repeatedValue) unmarshalFlat(data []byte) error {
+	var asBool []bool
+	if err := json.Unmarshal(data, &asBool); err == nil {
+		r.boolVal = asBool
+		return nil
+	}
+
+	var asString []string
+	if err := json.Unmarshal(data, &asString); err == nil {
+		r.stringVal = asString
+		return nil
+	}
+
+	if bytes.Contains(data, []byte(".")) {
+		var asFloat []float64
+		if err := json.Unmarshal(data, &asFloat); err != nil {
+			return err
+		}
+		r.doubleVal = asFloat
+		return nil
+	}
+
+	var asInt []int64
+	if err := json.Unmarshal(data, &asInt); err != nil {
+		return fmt.Errorf("unsupported repeatedValue element type: %w", err)
+	}
+	r.int64Val = asInt
+	return nil
+}
+
+func (r *repeatedValue) unmarshalNested(elements []json.RawMessage) error {
+	var asBool [][]bool
+	if err := json.Unmarshal(rawArray(elements), &asBool); err == nil {
+		r.boolListVal = asBool
+		return nil
+	}
+
+	var asString [][]string
+	if err := json.Unmarshal(rawArray(elements), &asString); err == nil {
+		r.stringListVal = asString
+		return nil
+	}
+
+	containsDecimal := false
+	for _, elem := range elements {
+		if bytes.Contains(elem, []byte(".")) {
+			containsDecimal = true
+			break
+		}
+	}
+
+	if containsDecimal {
+		var asFloat [][]float64
+		if err := json.Unmarshal(rawArray(elements), &asFloat); err != nil {
+			return err
+		}
+		r.doubleListVal = asFloat
+		return nil
+	}
+
+	var asInt [][]int64
+	if err := json.Unmarshal(rawArray(elements), &asInt); err != nil {
+		return fmt.Errorf("unsupported repeatedValue nested element type: %w", err)
+	}
+	r.int64ListVal = asInt
+	return nil
+}
+
+func rawArray(elements []json.RawMessage) []byte {
+	marshaled, _ := json.Marshal(elements)
+	return marshaled
+}
+
+// toProto converts the resolved typed field into the equivalent prototypes.RepeatedValue.
+func (r *repeatedValue) toProto() *prototypes.RepeatedValue {
+	values := make([]*prototypes.Value, 0)
+
+	switch {
+	case r.int64Val != nil:
+		for _, v := range r.int64Val {
+			values = append(values, &prototypes.Value{Val: &prototypes.Value_Int64Val{Int64Val: v}})
+		}
+	case r.doubleVal != nil:
+		for _, v := range r.doubleVal {
+			values = append(values, &prototypes.Value{Val: &prototypes.Value_DoubleVal{DoubleVal: v}})
+		}
+	case r.stringVal != nil:
+		for _, v := range r.stringVal {
+			values = append(values, &prototypes.Value{Val: &prototypes.Value_StringVal{StringVal: v}})
+		}
+	case r.boolVal != nil:
+		for _, v := range r.boolVal {
+			values = append(values, &prototypes.Value{Val: &prototypes.Value_BoolVal{BoolVal: v}})
+		}
+	case r.int64ListVal != nil:
+		for _, row := range r.int64ListVal {
+			values = append(values, &prototypes.Value{Val: &prototypes.Value_Int64ListVal{Int64ListVal: &prototypes.Int64List{Val: row}}})
+		}
+	case r.doubleListVal != nil:
+		for _, row := range r.doubleListVal {
+			values = append(values, &prototypes.Value{Val: &prototypes.Value_DoubleListVal{DoubleListVal: &prototypes.DoubleList{Val: row}}})
+		}
+	case r.stringListVal != nil:
+		for _, row := range r.stringListVal {
+			values = append(values, &prototypes.Value{Val: &prototypes.Value_StringListVal{StringListVal: &prototypes.StringList{Val: row}}})
+		}
+	case r.boolListVal != nil:
+		for _, row := range r.boolListVal {
+			values = append(values, &prototypes.Value{Val: &prototypes.Value_BoolListVal{BoolListVal: &prototypes.BoolList{Val: row}}})
+		}
+	}
+
+	return &prototypes.RepeatedValue{Val: values}
+}
@@ -0,0 +1,199 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/feast-dev/feast/go/protos/feast/serving"
+	prototypes "github.com/feast-dev/feast/go/protos/feast/types"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// defaultStreamChunkSize bounds how many entity rows are dispatched to
+	// feast.FeatureStore.GetOnlineFeatures per shard unless the request overrides it.
+	defaultStreamChunkSize = 500
+	// defaultStreamWorkers bounds how many shards run concurrently per streaming request.
+	defaultStreamWorkers = 4
+)
+
+// streamGetOnlineFeaturesRequest is the JSON body of POST /get-online-features:stream. It
+// has the same entity/feature shape as getOnlineFeaturesRequest, plus ChunkSize, since the
+// whole point of this endpoint is serving batches too large to buffer in one response.
+type streamGetOnlineFeaturesRequest struct {
+	FeatureService   *string                  `json:"feature_service"`
+	Features         []string                 `json:"features"`
+	Entities         map[string]repeatedValue `json:"entities"`
+	RequestContext   map[string]repeatedValue `json:"request_context"`
+	FullFeatureNames bool                     `json:"full_feature_names"`
+	ChunkSize        int                      `json:"chunk_size"`
+}
+
+// rowRange is a contiguous, half-open slice of entity rows, [start, end), dispatched as
+// one shard.
+type rowRange struct {
+	start, end int
+}
+
+func chunkRowRanges(numRows, chunkSize int) []rowRange {
+	if chunkSize <= 0 {
+		chunkSize = defaultStreamChunkSize
+	}
+	ranges := make([]rowRange, 0, (numRows+chunkSize-1)/chunkSize)
+	for start := 0; start < numRows; start += chunkSize {
+		end := start + chunkSize
+		if end > numRows {
+			end = numRows
+		}
+		ranges = append(ranges, rowRange{start, end})
+	}
+	if len(ranges) == 0 {
+		ranges = append(ranges, rowRange{0, 0})
+	}
+	return ranges
+}
+
+func rowCount(entities map[string]*prototypes.RepeatedValue) int {
+	for _, values := range entities {
+		return len(values.Val)
+	}
+	return 0
+}
+
+func sliceEntities(entities map[string]*prototypes.RepeatedValue, r rowRange) map[string]*prototypes.RepeatedValue {
+	sliced := make(map[string]*prototypes.RepeatedValue, len(entities))
+	for name, values := range entities {
+		sliced[name] = &prototypes.RepeatedValue{Val: values.Val[r.start:r.end]}
+	}
+	return sliced
+}
+
+// chunkResult pairs a shard's position with its outcome so results can be streamed out in
+// the order shards complete, not the order they were dispatched.
+type chunkResult struct {
+	index    int
+	response *serving.GetOnlineFeaturesResponse
+	err      error
+}
+
+// streamChunkFrame is one ndjson line emitted by getOnlineFeaturesStream. Shards complete
+// out of order, so every line carries its own row range back to the client instead of
+// relying on arrival order, letting a batch-scoring client reassemble results against the
+// entity rows it sent regardless of which shard finished first.
+type streamChunkFrame struct {
+	ChunkIndex int             `json:"chunk_index"`
+	RowStart   int             `json:"row_start"`
+	RowEnd     int             `json:"row_end"`
+	Response   json.RawMessage `json:"response,omitempty"`
+	Error      string          `json:"error,omitempty"`
+}
+
+// getOnlineFeaturesStream handles POST /get-online-features:stream: it splits the request
+// into row-range shards, runs them concurrently over a bounded worker pool, and flushes
+// each shard's response as a newline-delimited JSON line as soon as it completes, instead
+// of buffering the whole batch in memory.
+func (s *HttpServer) getOnlineFeaturesStream(w http.ResponseWriter, req *http.Request) {
+	req.Body = http.MaxBytesReader(w, req.Body, s.maxRecvSize)
+
+	var request streamGetOnlineFeaturesRequest
+	if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			writeStructuredError(w, http.StatusRequestEntityTooLarge, err)
+			return
+		}
+		writeStructuredError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if len(request.Features) == 0 && request.FeatureService == nil {
+		writeStructuredError(w, http.StatusBadRequest, errors.New("one of features or feature_service must be set"))
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeStructuredError(w, http.StatusInternalServerError, errors.New("streaming unsupported by this response writer"))
+		return
+	}
+
+	entities := toProtoMap(request.Entities)
+	requestContext := toProtoMap(request.RequestContext)
+	ranges := chunkRowRanges(rowCount(entities), request.ChunkSize)
+
+	ctx := req.Context()
+	results := make(chan chunkResult, len(ranges))
+	semaphore := make(chan struct{}, defaultStreamWorkers)
+	var wg sync.WaitGroup
+
+	for idx, r := range ranges {
+		wg.Add(1)
+		go func(idx int, r rowRange) {
+			defer wg.Done()
+
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				results <- chunkResult{index: idx, err: ctx.Err()}
+				return
+			}
+
+			rpcRequest := &serving.GetOnlineFeaturesRequest{
+				Kind:             &serving.GetOnlineFeaturesRequest_Features{Features: &serving.FeatureList{Val: request.Features}},
+				Entities:         sliceEntities(entities, r),
+				RequestContext:   requestContext,
+				FullFeatureNames: request.FullFeatureNames,
+			}
+			if request.FeatureService != nil {
+				rpcRequest.Kind = &serving.GetOnlineFeaturesRequest_FeatureService{FeatureService: *request.FeatureService}
+			}
+
+			response, err := s.grpc.GetOnlineFeatures(ctx, rpcRequest)
+			results <- chunkResult{index: idx, response: response, err: err}
+		}(idx, r)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+
+	for result := range results {
+		if ctx.Err() != nil {
+			return
+		}
+
+		frame := streamChunkFrame{
+			ChunkIndex: result.index,
+			RowStart:   ranges[result.index].start,
+			RowEnd:     ranges[result.index].end,
+		}
+		if result.err != nil {
+			frame.Error = result.err.Error()
+		} else if body, err := protojson.Marshal(result.response); err != nil {
+			frame.Error = err.Error()
+		} else {
+			frame.Response = body
+		}
+
+		line, err := json.Marshal(frame)
+		if err != nil {
+			_ = json.NewEncoder(w).Encode(structuredError{Error: err.Error()})
+			flusher.Flush()
+			continue
+		}
+		w.Write(line)
+		w.Write([]byte("\n"))
+		flusher.Flush()
+	}
+}
+
+// Note: this endpoint is HTTP-only for now. A gRPC server-streaming counterpart needs a
+// new ServingService RPC and a chunk_size field on GetOnlineFeaturesRequest, which is a
+// .proto change (plus regenerating the stubs) that doesn't belong in this change; tracked
+// as a separate follow-up (zabarn/feast#chunk1-4).
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	prototypes "github.com/feast-dev/feast/go/protos/feast/types"
+)
+
+func TestChunkRowRanges(t *testing.T) {
+	assert.Equal(t, []rowRange{{0, 3}, {3, 6}, {6, 7}}, chunkRowRanges(7, 3))
+	assert.Equal(t, []rowRange{{0, 5}}, chunkRowRanges(5, 10))
+	assert.Equal(t, []rowRange{{0, 0}}, chunkRowRanges(0, 10))
+	assert.Equal(t, []rowRange{{0, 3}, {3, 4}}, chunkRowRanges(4, 3))
+}
+
+func TestSliceEntities(t *testing.T) {
+	entities := map[string]*prototypes.RepeatedValue{
+		"driver_id": {Val: []*prototypes.Value{
+			{Val: &prototypes.Value_Int64Val{Int64Val: 1}},
+			{Val: &prototypes.Value_Int64Val{Int64Val: 2}},
+			{Val: &prototypes.Value_Int64Val{Int64Val: 3}},
+		}},
+	}
+
+	sliced := sliceEntities(entities, rowRange{1, 3})
+	assert.Len(t, sliced["driver_id"].Val, 2)
+	assert.Equal(t, int64(2), sliced["driver_id"].Val[0].GetInt64Val())
+	assert.Equal(t, int64(3), sliced["driver_id"].Val[1].GetInt64Val())
+}
+
+func TestRowCount(t *testing.T) {
+	assert.Equal(t, 0, rowCount(map[string]*prototypes.RepeatedValue{}))
+
+	entities := map[string]*prototypes.RepeatedValue{
+		"driver_id": {Val: []*prototypes.Value{{}, {}, {}}},
+	}
+	assert.Equal(t, 3, rowCount(entities))
+}
@@ -0,0 +1,185 @@
+package transformation
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/flight"
+	"github.com/apache/arrow/go/v8/arrow/ipc"
+	"github.com/feast-dev/feast/go/internal/feast/model"
+	"github.com/feast-dev/feast/go/internal/feast/onlineserving"
+	prototypes "github.com/feast-dev/feast/go/protos/feast/types"
+	"github.com/feast-dev/feast/go/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// flightTransformationService speaks Arrow Flight's DoExchange to the transformation
+// server instead of framing the Arrow IPC file inside a protobuf ValueType_ArrowValue,
+// so large batches stream record-batch-by-record-batch rather than being marshaled and
+// copied twice (once into the IPC file buffer, once into the protobuf request).
+type flightTransformationService struct {
+	endpoint string
+	project  string
+	client   flight.Client
+}
+
+// NewFlightTransformationService dials endpoint once, reusing the connection across
+// DoExchange calls the same way grpcTransformationService reuses its gRPC connection.
+func NewFlightTransformationService(endpoint string, project string, config *TransformationServiceConfig) (*flightTransformationService, error) {
+	dialOpts := config.dialOptions()
+	if len(dialOpts) == 0 {
+		dialOpts = []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	}
+
+	client, err := flight.NewClientWithMiddleware(endpoint, nil, nil, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("could not dial flight transformation service at %s: %w", endpoint, err)
+	}
+
+	return &flightTransformationService{endpoint: endpoint, project: project, client: client}, nil
+}
+
+// Close releases the underlying Flight/gRPC connection.
+func (s *flightTransformationService) Close() error {
+	return s.client.Close()
+}
+
+func (s *flightTransformationService) GetTransformation(
+	ctx context.Context,
+	featureView *model.OnDemandFeatureView,
+	requestData map[string]*prototypes.RepeatedValue,
+	entityRows map[string]*prototypes.RepeatedValue,
+	features []*onlineserving.FeatureVector,
+	numRows int,
+	fullFeatureNames bool,
+) ([]*onlineserving.FeatureVector, error) {
+	inputFields := make([]arrow.Field, 0)
+	inputColumns := make([]arrow.Array, 0)
+	for _, vector := range features {
+		inputFields = append(inputFields, arrow.Field{Name: vector.Name, Type: vector.Values.DataType()})
+		inputColumns = append(inputColumns, vector.Values)
+	}
+	for name, values := range requestData {
+		arr, err := types.ProtoValuesToArrowArray(values.Val, arrowAllocator, numRows)
+		if err != nil {
+			return nil, err
+		}
+		inputFields = append(inputFields, arrow.Field{Name: name, Type: arr.DataType()})
+		inputColumns = append(inputColumns, arr)
+	}
+	for name, values := range entityRows {
+		arr, err := types.ProtoValuesToArrowArray(values.Val, arrowAllocator, numRows)
+		if err != nil {
+			return nil, err
+		}
+		inputFields = append(inputFields, arrow.Field{Name: name, Type: arr.DataType()})
+		inputColumns = append(inputColumns, arr)
+	}
+
+	inputSchema := arrow.NewSchema(inputFields, nil)
+	inputRecord := array.NewRecord(inputSchema, inputColumns, int64(numRows))
+	defer inputRecord.Release()
+
+	descriptor := &flight.FlightDescriptor{
+		Type: flight.DescriptorPATH,
+		Path: []string{s.project, featureView.Base.Name},
+	}
+
+	stream, err := s.client.DoExchange(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.CloseSend()
+
+	writer := flight.NewRecordWriter(stream, ipc.WithSchema(inputSchema))
+	writer.SetFlightDescriptor(descriptor)
+	if err := writer.Write(inputRecord); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	reader, err := flight.NewRecordReader(stream)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Release()
+
+	var batches []arrow.Record
+	defer func() {
+		for _, batch := range batches {
+			batch.Release()
+		}
+	}()
+	for {
+		batch, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		batch.Retain()
+		batches = append(batches, batch)
+	}
+
+	if len(batches) == 0 {
+		return []*onlineserving.FeatureVector{}, nil
+	}
+
+	outRecord, err := concatRecordBatches(batches)
+	if err != nil {
+		return nil, err
+	}
+	defer outRecord.Release()
+
+	return extractRecordToFeatureVectors(featureView, outRecord, numRows, fullFeatureNames)
+}
+
+// concatRecordBatches merges the record batches streamed back over DoExchange into a
+// single record so extractRecordToFeatureVectors can treat the Flight transport the same
+// as the one-shot IPC file transport, regardless of how many batches the server sent.
+func concatRecordBatches(batches []arrow.Record) (arrow.Record, error) {
+	if len(batches) == 1 {
+		batches[0].Retain()
+		return batches[0], nil
+	}
+
+	schema := batches[0].Schema()
+	numCols := schema.NumFields()
+	columns := make([]arrow.Array, numCols)
+	release := func() {
+		for _, column := range columns {
+			if column != nil {
+				column.Release()
+			}
+		}
+	}
+
+	var numRows int64
+	for _, batch := range batches {
+		numRows += batch.NumRows()
+	}
+
+	for col := 0; col < numCols; col++ {
+		parts := make([]arrow.Array, len(batches))
+		for i, batch := range batches {
+			parts[i] = batch.Column(col)
+		}
+		merged, err := array.Concatenate(parts, arrowAllocator)
+		if err != nil {
+			release()
+			return nil, err
+		}
+		columns[col] = merged
+	}
+
+	outRecord := array.NewRecord(schema, columns, numRows)
+	release()
+	return outRecord, nil
+}
@@ -0,0 +1,146 @@
+package transformation
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/ipc"
+	"github.com/feast-dev/feast/go/internal/feast/model"
+	"github.com/feast-dev/feast/go/internal/feast/onlineserving"
+	prototypes "github.com/feast-dev/feast/go/protos/feast/types"
+	"github.com/feast-dev/feast/go/types"
+)
+
+const arrowFileContentType = "application/vnd.apache.arrow.file"
+
+// httpTransformationService posts the Arrow IPC payload to a Feast feature-server-style
+// HTTP endpoint instead of calling a TransformationService over gRPC, for deployments
+// that front their ODFV transformer with a plain HTTP service.
+type httpTransformationService struct {
+	endpoint string
+	project  string
+	client   *http.Client
+}
+
+// NewHttpTransformationService returns a TransformationService that POSTs to endpoint.
+func NewHttpTransformationService(endpoint string, project string) *httpTransformationService {
+	return &httpTransformationService{
+		endpoint: endpoint,
+		project:  project,
+		client:   http.DefaultClient,
+	}
+}
+
+type httpTransformationRequest struct {
+	OnDemandFeatureViewName string `json:"on_demand_feature_view_name"`
+	Project                 string `json:"project"`
+	ArrowValueB64           string `json:"arrow_value_b64"`
+}
+
+type httpTransformationResponse struct {
+	ArrowValueB64 string `json:"arrow_value_b64"`
+}
+
+func (s *httpTransformationService) GetTransformation(
+	ctx context.Context,
+	featureView *model.OnDemandFeatureView,
+	requestData map[string]*prototypes.RepeatedValue,
+	entityRows map[string]*prototypes.RepeatedValue,
+	features []*onlineserving.FeatureVector,
+	numRows int,
+	fullFeatureNames bool,
+) ([]*onlineserving.FeatureVector, error) {
+	arrowMemory := arrowAllocator
+
+	inputFields := make([]arrow.Field, 0)
+	inputColumns := make([]arrow.Array, 0)
+	for _, vector := range features {
+		inputFields = append(inputFields, arrow.Field{Name: vector.Name, Type: vector.Values.DataType()})
+		inputColumns = append(inputColumns, vector.Values)
+	}
+	for name, values := range requestData {
+		arr, err := types.ProtoValuesToArrowArray(values.Val, arrowMemory, numRows)
+		if err != nil {
+			return nil, err
+		}
+		inputFields = append(inputFields, arrow.Field{Name: name, Type: arr.DataType()})
+		inputColumns = append(inputColumns, arr)
+	}
+	for name, values := range entityRows {
+		arr, err := types.ProtoValuesToArrowArray(values.Val, arrowMemory, numRows)
+		if err != nil {
+			return nil, err
+		}
+		inputFields = append(inputFields, arrow.Field{Name: name, Type: arr.DataType()})
+		inputColumns = append(inputColumns, arr)
+	}
+
+	inputRecord := array.NewRecord(arrow.NewSchema(inputFields, nil), inputColumns, int64(numRows))
+	defer inputRecord.Release()
+
+	recordValueWriter := getByteSliceWriter()
+	defer putByteSliceWriter(recordValueWriter)
+	arrowWriter, err := ipc.NewFileWriter(recordValueWriter)
+	if err != nil {
+		return nil, err
+	}
+	if err := arrowWriter.Write(inputRecord); err != nil {
+		return nil, err
+	}
+	if err := arrowWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	reqBody, err := json.Marshal(httpTransformationRequest{
+		OnDemandFeatureViewName: featureView.Base.Name,
+		Project:                 s.project,
+		ArrowValueB64:           base64.StdEncoding.EncodeToString(recordValueWriter.buf),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", arrowFileContentType+", application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("transformation service at %s returned status %d: %s", s.endpoint, resp.StatusCode, body)
+	}
+
+	var arrowBytes []byte
+	if resp.Header.Get("Content-Type") == arrowFileContentType {
+		arrowBytes, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var parsed httpTransformationResponse
+		if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+			return nil, err
+		}
+		arrowBytes, err = base64.StdEncoding.DecodeString(parsed.ArrowValueB64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ExtractTransformationResponse(featureView, arrowBytes, numRows, fullFeatureNames)
+}
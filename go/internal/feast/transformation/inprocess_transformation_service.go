@@ -0,0 +1,63 @@
+package transformation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/feast-dev/feast/go/internal/feast/model"
+	"github.com/feast-dev/feast/go/internal/feast/onlineserving"
+	prototypes "github.com/feast-dev/feast/go/protos/feast/types"
+)
+
+// InProcessFunc is a pure-Go ODFV transformation. It has the same shape as
+// TransformationService.GetTransformation so a registered function can be called
+// directly, without a sidecar or network hop.
+type InProcessFunc func(
+	ctx context.Context,
+	featureView *model.OnDemandFeatureView,
+	requestData map[string]*prototypes.RepeatedValue,
+	entityRows map[string]*prototypes.RepeatedValue,
+	features []*onlineserving.FeatureVector,
+	numRows int,
+	fullFeatureNames bool,
+) ([]*onlineserving.FeatureVector, error)
+
+var (
+	inProcessFuncsMu sync.RWMutex
+	inProcessFuncs   = map[string]InProcessFunc{}
+)
+
+// RegisterFunction makes an InProcessFunc available to the "inprocess" transformation
+// service backend under name, for use as feature_server.transformation_service.endpoint.
+func RegisterFunction(name string, fn InProcessFunc) {
+	inProcessFuncsMu.Lock()
+	defer inProcessFuncsMu.Unlock()
+	inProcessFuncs[name] = fn
+}
+
+type inProcessTransformationService struct {
+	fn InProcessFunc
+}
+
+func newInProcessTransformationService(name string) (*inProcessTransformationService, error) {
+	inProcessFuncsMu.RLock()
+	fn, ok := inProcessFuncs[name]
+	inProcessFuncsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no in-process transformation function registered under name %q", name)
+	}
+	return &inProcessTransformationService{fn: fn}, nil
+}
+
+func (s *inProcessTransformationService) GetTransformation(
+	ctx context.Context,
+	featureView *model.OnDemandFeatureView,
+	requestData map[string]*prototypes.RepeatedValue,
+	entityRows map[string]*prototypes.RepeatedValue,
+	features []*onlineserving.FeatureVector,
+	numRows int,
+	fullFeatureNames bool,
+) ([]*onlineserving.FeatureVector, error) {
+	return s.fn(ctx, featureView, requestData, entityRows, features, numRows, fullFeatureNames)
+}
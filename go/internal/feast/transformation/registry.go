@@ -0,0 +1,81 @@
+package transformation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/feast-dev/feast/go/internal/feast/model"
+	"github.com/feast-dev/feast/go/internal/feast/onlineserving"
+	prototypes "github.com/feast-dev/feast/go/protos/feast/types"
+)
+
+// TransformationService executes an on-demand feature view's transformation against a
+// remote or in-process backend, turning request data, entity rows, and already-resolved
+// feature vectors into the ODFV's output feature vectors.
+type TransformationService interface {
+	GetTransformation(
+		ctx context.Context,
+		featureView *model.OnDemandFeatureView,
+		requestData map[string]*prototypes.RepeatedValue,
+		entityRows map[string]*prototypes.RepeatedValue,
+		features []*onlineserving.FeatureVector,
+		numRows int,
+		fullFeatureNames bool,
+	) ([]*onlineserving.FeatureVector, error)
+}
+
+// Config carries the feature_server.transformation_service section of RepoConfig that
+// selects and parameterizes a TransformationService backend.
+type Config struct {
+	// Type selects the registered backend, e.g. "grpc", "http", or "inprocess".
+	Type string
+	// Endpoint is backend-specific: a gRPC/HTTP address for "grpc"/"http", or the
+	// registered function name for "inprocess".
+	Endpoint string
+	Project  string
+	// Grpc carries the transport options used by the "grpc" and "flight" backends.
+	Grpc *TransformationServiceConfig
+}
+
+// Factory constructs a TransformationService from Config. Implementations are expected
+// to fail fast in the factory rather than on the first GetTransformation call.
+type Factory func(cfg *Config) (TransformationService, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = map[string]Factory{
+		"grpc": func(cfg *Config) (TransformationService, error) {
+			return NewGrpcTransformationService(cfg.Endpoint, cfg.Project, cfg.Grpc)
+		},
+		"flight": func(cfg *Config) (TransformationService, error) {
+			return NewFlightTransformationService(cfg.Endpoint, cfg.Project, cfg.Grpc)
+		},
+		"http": func(cfg *Config) (TransformationService, error) {
+			return NewHttpTransformationService(cfg.Endpoint, cfg.Project), nil
+		},
+		"inprocess": func(cfg *Config) (TransformationService, error) {
+			return newInProcessTransformationService(cfg.Endpoint)
+		},
+	}
+)
+
+// Register adds, or replaces, the factory used to construct the named backend. This
+// lets downstream users embedding the Go SDK plug in their own TransformationService
+// implementation without forking this module.
+func Register(name string, factory Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	factories[name] = factory
+}
+
+// NewTransformationService looks up the backend named by cfg.Type and constructs it.
+func NewTransformationService(cfg *Config) (TransformationService, error) {
+	factoriesMu.RLock()
+	factory, ok := factories[cfg.Type]
+	factoriesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no transformation service backend registered for type %q", cfg.Type)
+	}
+	return factory(cfg)
+}
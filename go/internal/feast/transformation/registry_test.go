@@ -0,0 +1,59 @@
+package transformation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/feast-dev/feast/go/internal/feast/model"
+	"github.com/feast-dev/feast/go/internal/feast/onlineserving"
+	prototypes "github.com/feast-dev/feast/go/protos/feast/types"
+)
+
+func TestNewTransformationServiceUnknownType(t *testing.T) {
+	_, err := NewTransformationService(&Config{Type: "does-not-exist"})
+	assert.NotNil(t, err)
+}
+
+func TestNewTransformationServiceInProcess(t *testing.T) {
+	RegisterFunction("test-echo", func(
+		ctx context.Context,
+		featureView *model.OnDemandFeatureView,
+		requestData map[string]*prototypes.RepeatedValue,
+		entityRows map[string]*prototypes.RepeatedValue,
+		features []*onlineserving.FeatureVector,
+		numRows int,
+		fullFeatureNames bool,
+	) ([]*onlineserving.FeatureVector, error) {
+		return features, nil
+	})
+
+	svc, err := NewTransformationService(&Config{Type: "inprocess", Endpoint: "test-echo"})
+	assert.Nil(t, err)
+
+	features := []*onlineserving.FeatureVector{{Name: "f1"}}
+	result, err := svc.GetTransformation(context.Background(), &model.OnDemandFeatureView{}, nil, nil, features, 0, false)
+	assert.Nil(t, err)
+	assert.Equal(t, features, result)
+}
+
+func TestRegisterCustomBackend(t *testing.T) {
+	Register("custom", func(cfg *Config) (TransformationService, error) {
+		return &inProcessTransformationService{fn: func(
+			ctx context.Context,
+			featureView *model.OnDemandFeatureView,
+			requestData map[string]*prototypes.RepeatedValue,
+			entityRows map[string]*prototypes.RepeatedValue,
+			features []*onlineserving.FeatureVector,
+			numRows int,
+			fullFeatureNames bool,
+		) ([]*onlineserving.FeatureVector, error) {
+			return nil, nil
+		}}, nil
+	})
+
+	svc, err := NewTransformationService(&Config{Type: "custom"})
+	assert.Nil(t, err)
+	assert.NotNil(t, svc)
+}
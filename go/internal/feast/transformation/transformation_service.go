@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/apache/arrow/go/v8/arrow"
 	"github.com/apache/arrow/go/v8/arrow/array"
@@ -17,12 +19,109 @@ import (
 	prototypes "github.com/feast-dev/feast/go/protos/feast/types"
 	"github.com/feast-dev/feast/go/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
 	"io"
 )
 
+// TransformationServiceConfig surfaces the gRPC transport knobs that matter for a
+// long-lived connection to an on-demand transformation server: message size caps,
+// keepalive behavior, TLS, and the load-balancing policy. It has no option for
+// client-side gRPC tracing.
+type TransformationServiceConfig struct {
+	// MaxReceivedMessageSize caps the size (in bytes) of a TransformFeatures response.
+	// Zero means use the grpc-go default.
+	MaxReceivedMessageSize int
+	// MaxSendMessageSize caps the size (in bytes) of a TransformFeatures request.
+	// Zero means use the grpc-go default.
+	MaxSendMessageSize int
+	// Keepalive configures client-side HTTP/2 keepalive pings, useful for detecting a
+	// wedged transformation server or load balancer behind an idle connection.
+	Keepalive *keepalive.ClientParameters
+	// TLSCredentials, when set, upgrades the connection to TLS. A nil value dials
+	// insecurely, which is the pre-existing default behavior.
+	TLSCredentials credentials.TransportCredentials
+	// LoadBalancingPolicy selects the client-side LB policy (e.g. "round_robin") used
+	// when the endpoint resolves to multiple addresses, so requests can be spread
+	// across several ODFV transformer replicas behind DNS.
+	LoadBalancingPolicy string
+}
+
+// dialOptions builds the dial options for c. c may be nil — a caller that doesn't set
+// TransformationServiceConfig still gets the fail-fast WithBlock and round_robin
+// load-balancing behavior, just with insecure credentials and no message size caps.
+func (c *TransformationServiceConfig) dialOptions() []grpc.DialOption {
+	if c == nil {
+		c = &TransformationServiceConfig{}
+	}
+
+	opts := make([]grpc.DialOption, 0)
+
+	if c.TLSCredentials != nil {
+		opts = append(opts, grpc.WithTransportCredentials(c.TLSCredentials))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	callOpts := make([]grpc.CallOption, 0)
+	if c.MaxReceivedMessageSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallRecvMsgSize(c.MaxReceivedMessageSize))
+	}
+	if c.MaxSendMessageSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallSendMsgSize(c.MaxSendMessageSize))
+	}
+	if len(callOpts) > 0 {
+		opts = append(opts, grpc.WithDefaultCallOptions(callOpts...))
+	}
+
+	if c.Keepalive != nil {
+		opts = append(opts, grpc.WithKeepaliveParams(*c.Keepalive))
+	}
+
+	lbPolicy := c.LoadBalancingPolicy
+	if lbPolicy == "" {
+		lbPolicy = "round_robin"
+	}
+	opts = append(opts,
+		grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"loadBalancingPolicy":"%s"}`, lbPolicy)),
+		grpc.WithBlock(),
+	)
+
+	return opts
+}
+
+// NewGrpcTransformationService dials endpoint once and returns a TransformationService
+// backed by that single long-lived connection, shared across every GetTransformation
+// call instead of re-dialing per request.
+func NewGrpcTransformationService(endpoint string, project string, config *TransformationServiceConfig) (*grpcTransformationService, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, endpoint, config.dialOptions()...)
+	if err != nil {
+		return nil, fmt.Errorf("could not dial transformation service at %s: %w", endpoint, err)
+	}
+
+	return &grpcTransformationService{
+		endpoint: endpoint,
+		project:  project,
+		conn:     conn,
+		client:   serving.NewTransformationServiceClient(conn),
+	}, nil
+}
+
 type grpcTransformationService struct {
 	endpoint string
 	project  string
+	conn     *grpc.ClientConn
+	client   serving.TransformationServiceClient
+}
+
+// Close releases the underlying gRPC connection. It should be called once, when the
+// owning FeatureStore is torn down.
+func (s *grpcTransformationService) Close() error {
+	return s.conn.Close()
 }
 
 func (s *grpcTransformationService) GetTransformation(
@@ -35,7 +134,7 @@ func (s *grpcTransformationService) GetTransformation(
 	fullFeatureNames bool,
 ) ([]*onlineserving.FeatureVector, error) {
 	var err error
-	arrowMemory := memory.NewGoAllocator()
+	arrowMemory := arrowAllocator
 
 	inputFields := make([]arrow.Field, 0)
 	inputColumns := make([]arrow.Array, 0)
@@ -65,7 +164,8 @@ func (s *grpcTransformationService) GetTransformation(
 	inputRecord := array.NewRecord(arrow.NewSchema(inputFields, nil), inputColumns, int64(numRows))
 	defer inputRecord.Release()
 
-	recordValueWriter := new(ByteSliceWriter)
+	recordValueWriter := getByteSliceWriter()
+	defer putByteSliceWriter(recordValueWriter)
 	arrowWriter, err := ipc.NewFileWriter(recordValueWriter)
 	if err != nil {
 		return nil, err
@@ -74,6 +174,9 @@ func (s *grpcTransformationService) GetTransformation(
 	if err != nil {
 		return nil, err
 	}
+	if err := arrowWriter.Close(); err != nil {
+		return nil, err
+	}
 	arrowInput := serving.ValueType_ArrowValue{ArrowValue: recordValueWriter.buf}
 	transformationInput := serving.ValueType{Value: &arrowInput}
 
@@ -83,17 +186,7 @@ func (s *grpcTransformationService) GetTransformation(
 		TransformationInput:     &transformationInput,
 	}
 
-	opts := make([]grpc.DialOption, 0)
-	opts = append(opts, grpc.WithDefaultCallOptions())
-
-	conn, err := grpc.Dial(s.endpoint, opts...)
-	if err != nil {
-		return nil, err
-	}
-	defer conn.Close()
-	client := serving.NewTransformationServiceClient(conn)
-
-	res, err := client.TransformFeatures(ctx, &req)
+	res, err := s.client.TransformFeatures(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
@@ -109,8 +202,7 @@ func ExtractTransformationResponse(
 	fullFeatureNames bool,
 ) ([]*onlineserving.FeatureVector, error) {
 	reader := bytes.NewReader(arrowBytes)
-	arrowMemory := memory.NewGoAllocator()
-	arrowReader, err := ipc.NewFileReader(reader, ipc.WithAllocator(arrowMemory))
+	arrowReader, err := ipc.NewFileReader(reader, ipc.WithAllocator(arrowAllocator))
 	if err != nil {
 		return nil, err
 	}
@@ -119,16 +211,59 @@ func ExtractTransformationResponse(
 	if err != nil {
 		return nil, err
 	}
+	return extractRecordToFeatureVectors(featureView, outRecord, numRows, fullFeatureNames)
+}
+
+// Sidecar column name prefixes a transformation server may use to report, per output
+// feature, the per-row FieldStatus and event timestamp that ExtractTransformationResponse
+// would otherwise have to fabricate. A sidecar column for feature "conv_rate" is named
+// "__status__conv_rate" / "__event_timestamp__conv_rate" so the prefix can't collide with
+// the "view__feature" convention used for full feature names.
+const (
+	statusColumnPrefix         = "__status__"
+	eventTimestampColumnPrefix = "__event_timestamp__"
+)
+
+// extractRecordToFeatureVectors turns a decoded Arrow record into the ODFV's output
+// feature vectors. It is shared by the IPC-file transport (ExtractTransformationResponse)
+// and the Arrow Flight transport, which both end up with an in-memory arrow.Record but
+// get there over a different wire format.
+func extractRecordToFeatureVectors(
+	featureView *model.OnDemandFeatureView,
+	outRecord arrow.Record,
+	numRows int,
+	fullFeatureNames bool,
+) ([]*onlineserving.FeatureVector, error) {
+	statusColumns := make(map[string]arrow.Array)
+	eventTimestampColumns := make(map[string]*array.Timestamp)
+	eventTimestampUnits := make(map[string]arrow.TimeUnit)
+	for idx, field := range outRecord.Schema().Fields() {
+		switch {
+		case strings.HasPrefix(field.Name, statusColumnPrefix):
+			statusColumns[strings.TrimPrefix(field.Name, statusColumnPrefix)] = outRecord.Column(idx)
+		case strings.HasPrefix(field.Name, eventTimestampColumnPrefix):
+			featureName := strings.TrimPrefix(field.Name, eventTimestampColumnPrefix)
+			if ts, ok := outRecord.Column(idx).(*array.Timestamp); ok {
+				eventTimestampColumns[featureName] = ts
+				if tsType, ok := field.Type.(*arrow.TimestampType); ok {
+					eventTimestampUnits[featureName] = tsType.Unit
+				}
+			}
+		}
+	}
+
 	result := make([]*onlineserving.FeatureVector, 0)
 	for idx, field := range outRecord.Schema().Fields() {
+		if strings.HasPrefix(field.Name, statusColumnPrefix) || strings.HasPrefix(field.Name, eventTimestampColumnPrefix) {
+			continue
+		}
+
 		dropFeature := true
+		featureName := field.Name
 
 		if featureView.Base.Projection != nil {
-			var featureName string
 			if fullFeatureNames {
 				featureName = strings.Split(field.Name, "__")[1]
-			} else {
-				featureName = field.Name
 			}
 
 			for _, feature := range featureView.Base.Projection.Features {
@@ -144,17 +279,27 @@ func ExtractTransformationResponse(
 			continue
 		}
 
+		column := outRecord.Column(idx)
+		statusColumn := statusColumns[featureName]
+		eventTimestampColumn := eventTimestampColumns[featureName]
+		eventTimestampUnit := eventTimestampUnits[featureName]
+
 		statuses := make([]serving.FieldStatus, numRows)
 		timestamps := make([]*timestamppb.Timestamp, numRows)
 
-		for idx := 0; idx < numRows; idx++ {
-			statuses[idx] = serving.FieldStatus_PRESENT
-			timestamps[idx] = timestamppb.Now()
+		for row := 0; row < numRows; row++ {
+			statuses[row] = fieldStatusForRow(column, statusColumn, row)
+
+			if eventTimestampColumn != nil && !eventTimestampColumn.IsNull(row) {
+				timestamps[row] = timestamppb.New(eventTimestampColumn.Value(row).ToTime(eventTimestampUnit))
+			} else {
+				timestamps[row] = timestamppb.Now()
+			}
 		}
 
 		result = append(result, &onlineserving.FeatureVector{
 			Name:       field.Name,
-			Values:     outRecord.Column(idx),
+			Values:     column,
 			Statuses:   statuses,
 			Timestamps: timestamps,
 		})
@@ -162,11 +307,54 @@ func ExtractTransformationResponse(
 	return result, nil
 }
 
+// fieldStatusForRow derives the FieldStatus of a single row: an explicit sidecar status
+// column wins when present, otherwise nullness of the feature's own Arrow column tells us
+// whether the transformation server actually produced a value for that row.
+func fieldStatusForRow(column arrow.Array, statusColumn arrow.Array, row int) serving.FieldStatus {
+	if statusColumn != nil {
+		if intStatus, ok := statusColumn.(*array.Int32); ok && !intStatus.IsNull(row) {
+			return serving.FieldStatus(intStatus.Value(row))
+		}
+	}
+	if column.IsNull(row) {
+		return serving.FieldStatus_NULL_VALUE
+	}
+	return serving.FieldStatus_PRESENT
+}
+
+// arrowAllocator is shared across GetTransformation calls instead of constructing a
+// fresh memory.GoAllocator per request. memory.GoAllocator is stateless and safe for
+// concurrent use, unlike memory.CheckedAllocator, whose leak-tracking bookkeeping and
+// mutex are meant for test teardown (AssertSize), not a shared production hot path.
+var arrowAllocator = memory.NewGoAllocator()
+
+// byteSliceWriterPool recycles ByteSliceWriters so a fresh one, and its backing
+// buffer, doesn't have to be allocated on every GetTransformation call.
+var byteSliceWriterPool = sync.Pool{
+	New: func() interface{} { return new(ByteSliceWriter) },
+}
+
+func getByteSliceWriter() *ByteSliceWriter {
+	return byteSliceWriterPool.Get().(*ByteSliceWriter)
+}
+
+func putByteSliceWriter(w *ByteSliceWriter) {
+	w.Reset()
+	byteSliceWriterPool.Put(w)
+}
+
 type ByteSliceWriter struct {
 	buf    []byte
 	offset int64
 }
 
+// Reset empties the writer so it can be returned to byteSliceWriterPool and reused by
+// a later call without retaining the previous request's payload.
+func (w *ByteSliceWriter) Reset() {
+	w.buf = w.buf[:0]
+	w.offset = 0
+}
+
 func (w *ByteSliceWriter) Write(p []byte) (n int, err error) {
 	capacity := len(p)
 	writeSlice := w.buf[w.offset:]
@@ -192,14 +380,14 @@ func (w *ByteSliceWriter) Seek(offset int64, whence int) (int64, error) {
 		if newOffset != offset && (newOffset < 0 || newOffset > int64(len(w.buf))) {
 			return 0, fmt.Errorf("invalid seek: new offset %d out of range [0 %d]", offset, len(w.buf))
 		}
-		w.offset += offset
+		w.offset = newOffset
 		return w.offset, nil
 	case io.SeekEnd:
 		newOffset := int64(len(w.buf)) + offset
 		if newOffset != offset && (newOffset < 0 || newOffset > int64(len(w.buf))) {
 			return 0, fmt.Errorf("invalid seek: new offset %d out of range [0 %d]", offset, len(w.buf))
 		}
-		w.offset = offset
+		w.offset = newOffset
 		return w.offset, nil
 	}
 	return 0, fmt.Errorf("unsupported seek mode %d", whence)
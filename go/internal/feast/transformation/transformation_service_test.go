@@ -1,8 +1,14 @@
 package transformation
 
 import (
-  "testing"
-  "fmt"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -10,36 +16,122 @@ import (
 	"github.com/apache/arrow/go/v8/arrow/array"
 	"github.com/apache/arrow/go/v8/arrow/ipc"
 	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/feast-dev/feast/go/internal/feast/model"
+	"github.com/feast-dev/feast/go/protos/feast/serving"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
 )
 
-func TestSendGrpcRequest(t *testing.T) {
-  allocator := memory.NewGoAllocator()
-  dummyColumn := array.NewInt64Builder(allocator)
+const bufconnBufSize = 1024 * 1024
+
+// countingTransformationServer counts how many TransformFeatures calls it serves, so
+// tests can assert that N concurrent client calls all land on the one bufconn listener
+// backing a single shared grpcTransformationService connection.
+type countingTransformationServer struct {
+	serving.UnimplementedTransformationServiceServer
+	calls int64
+	// dials counts how many connections the client opened to the bufconn listener.
+	dials int64
+}
+
+func (s *countingTransformationServer) TransformFeatures(ctx context.Context, req *serving.TransformFeaturesRequest) (*serving.TransformFeaturesResponse, error) {
+	atomic.AddInt64(&s.calls, 1)
+	return &serving.TransformFeaturesResponse{
+		TransformationOutput: &serving.ValueType{Value: &serving.ValueType_ArrowValue{ArrowValue: []byte{}}},
+	}, nil
+}
+
+func dialBufconnTransformationService(t *testing.T, server *countingTransformationServer) (*grpcTransformationService, func()) {
+	t.Helper()
 
+	listener := bufconn.Listen(bufconnBufSize)
+	grpcServer := grpc.NewServer()
+	serving.RegisterTransformationServiceServer(grpcServer, server)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		atomic.AddInt64(&server.dials, 1)
+		return listener.Dial()
+	}
+
+	conn, err := grpc.DialContext(context.Background(), "bufconn",
+		grpc.WithContextDialer(dialer),
+		grpc.WithInsecure(),
+		grpc.WithBlock(),
+	)
+	assert.Nil(t, err)
+
+	svc := &grpcTransformationService{
+		endpoint: "bufconn",
+		project:  "test-project",
+		conn:     conn,
+		client:   serving.NewTransformationServiceClient(conn),
+	}
+
+	cleanup := func() {
+		_ = svc.Close()
+		grpcServer.Stop()
+	}
+	return svc, cleanup
+}
+
+// TestGetTransformationReusesConnection fires many concurrent TransformFeatures calls
+// through a single grpcTransformationService and asserts they are all served over the
+// one long-lived bufconn connection rather than dialing per call.
+func TestGetTransformationReusesConnection(t *testing.T) {
+	server := &countingTransformationServer{}
+	svc, cleanup := dialBufconnTransformationService(t, server)
+	defer cleanup()
+
+	const concurrentCalls = 20
+	var wg sync.WaitGroup
+	wg.Add(concurrentCalls)
+	for i := 0; i < concurrentCalls; i++ {
+		go func() {
+			defer wg.Done()
+			_, err := svc.client.TransformFeatures(context.Background(), &serving.TransformFeaturesRequest{
+				OnDemandFeatureViewName: "odfv",
+				Project:                 svc.project,
+				TransformationInput:     &serving.ValueType{Value: &serving.ValueType_ArrowValue{ArrowValue: []byte{}}},
+			})
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(concurrentCalls), atomic.LoadInt64(&server.calls))
+	assert.Equal(t, int64(1), atomic.LoadInt64(&server.dials))
+}
+
+func TestSendGrpcRequest(t *testing.T) {
+	allocator := memory.NewGoAllocator()
+	dummyColumn := array.NewInt64Builder(allocator)
 
 	inputFields := make([]arrow.Field, 0)
 	inputColumns := make([]arrow.Array, 0)
-  for i := 0; i < 10; i++ {
-    for j := 0; j < 10; j++ {
-      dummyColumn.Append(int64(j))
-    }
+	for i := 0; i < 10; i++ {
+		for j := 0; j < 10; j++ {
+			dummyColumn.Append(int64(j))
+		}
 		inputFields = append(inputFields, arrow.Field{Name: fmt.Sprintf("Field %d", i), Type: &arrow.Int64Type{}})
 		inputColumns = append(inputColumns, dummyColumn.NewArray())
-    switch col := inputColumns[i].(type) {
-    case *array.Int64:
-      fmt.Println(col.Int64Values())
-    case *array.Int32:
-      fmt.Println(col.Int32Values())
-    case *array.String:
-      fmt.Println(col.String())
-    case *array.Float32:
-      fmt.Println(col.Float32Values())
-    case *array.Float64:
-      fmt.Println(col.Float64Values())
-    }
+		switch col := inputColumns[i].(type) {
+		case *array.Int64:
+			fmt.Println(col.Int64Values())
+		case *array.Int32:
+			fmt.Println(col.Int32Values())
+		case *array.String:
+			fmt.Println(col.String())
+		case *array.Float32:
+			fmt.Println(col.Float32Values())
+		case *array.Float64:
+			fmt.Println(col.Float64Values())
+		}
 	}
 
-  inputSchema := arrow.NewSchema(inputFields, nil)
+	inputSchema := arrow.NewSchema(inputFields, nil)
 	inputRecord := array.NewRecord(inputSchema, inputColumns, int64(10))
 	defer inputRecord.Release()
 
@@ -47,7 +139,106 @@ func TestSendGrpcRequest(t *testing.T) {
 	arrowWriter, _ := ipc.NewFileWriter(recordValueWriter, ipc.WithSchema(inputSchema))
 	arrowWriter.Write(inputRecord)
 	arrowWriter.Close()
-  for i := range inputRecord.Columns() {
-    assert.Equal(t, fmt.Sprintf("Field %d", i), inputRecord.ColumnName(i))
+	for i := range inputRecord.Columns() {
+		assert.Equal(t, fmt.Sprintf("Field %d", i), inputRecord.ColumnName(i))
 	}
 }
+
+func TestByteSliceWriterSeek(t *testing.T) {
+	w := &ByteSliceWriter{}
+	_, err := w.Write([]byte("0123456789"))
+	assert.Nil(t, err)
+
+	offset, err := w.Seek(3, io.SeekStart)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), offset)
+	assert.Equal(t, int64(3), w.offset)
+
+	offset, err = w.Seek(2, io.SeekCurrent)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), offset)
+	assert.Equal(t, int64(5), w.offset)
+
+	offset, err = w.Seek(-4, io.SeekEnd)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6), offset)
+	assert.Equal(t, int64(6), w.offset)
+}
+
+func recordWithFields(t *testing.T, fields []arrow.Field, columns []arrow.Array) arrow.Record {
+	t.Helper()
+	return array.NewRecord(arrow.NewSchema(fields, nil), columns, int64(columns[0].Len()))
+}
+
+func TestExtractRecordToFeatureVectorsHonorsNullness(t *testing.T) {
+	allocator := memory.NewGoAllocator()
+
+	floatBuilder := array.NewFloat64Builder(allocator)
+	floatBuilder.Append(1.5)
+	floatBuilder.AppendNull()
+	floatArr := floatBuilder.NewArray()
+
+	featureView := &model.OnDemandFeatureView{Base: &model.BaseFeatureView{Name: "odfv1"}}
+
+	record := recordWithFields(t,
+		[]arrow.Field{{Name: "conv_rate", Type: arrow.PrimitiveTypes.Float64}},
+		[]arrow.Array{floatArr},
+	)
+	defer record.Release()
+
+	vectors, err := extractRecordToFeatureVectors(featureView, record, 2, false)
+	assert.Nil(t, err)
+	assert.Len(t, vectors, 1)
+	assert.Equal(t, serving.FieldStatus_PRESENT, vectors[0].Statuses[0])
+	assert.Equal(t, serving.FieldStatus_NULL_VALUE, vectors[0].Statuses[1])
+}
+
+func TestExtractRecordToFeatureVectorsHonorsSidecarColumns(t *testing.T) {
+	allocator := memory.NewGoAllocator()
+
+	floatBuilder := array.NewFloat64Builder(allocator)
+	floatBuilder.Append(1.5)
+	floatBuilder.Append(2.5)
+	floatArr := floatBuilder.NewArray()
+
+	statusBuilder := array.NewInt32Builder(allocator)
+	statusBuilder.Append(int32(serving.FieldStatus_PRESENT))
+	statusBuilder.Append(int32(serving.FieldStatus_NOT_FOUND))
+	statusArr := statusBuilder.NewArray()
+
+	eventTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tsType := &arrow.TimestampType{Unit: arrow.Second}
+	tsBuilder := array.NewTimestampBuilder(allocator, tsType)
+	tsBuilder.Append(arrow.Timestamp(eventTime.Unix()))
+	tsBuilder.Append(arrow.Timestamp(eventTime.Unix()))
+	tsArr := tsBuilder.NewArray()
+
+	featureView := &model.OnDemandFeatureView{Base: &model.BaseFeatureView{Name: "odfv1"}}
+
+	record := recordWithFields(t,
+		[]arrow.Field{
+			{Name: "conv_rate", Type: arrow.PrimitiveTypes.Float64},
+			{Name: "__status__conv_rate", Type: arrow.PrimitiveTypes.Int32},
+			{Name: "__event_timestamp__conv_rate", Type: tsType},
+		},
+		[]arrow.Array{floatArr, statusArr, tsArr},
+	)
+	defer record.Release()
+
+	vectors, err := extractRecordToFeatureVectors(featureView, record, 2, false)
+	assert.Nil(t, err)
+	assert.Len(t, vectors, 1)
+	assert.Equal(t, serving.FieldStatus_PRESENT, vectors[0].Statuses[0])
+	assert.Equal(t, serving.FieldStatus_NOT_FOUND, vectors[0].Statuses[1])
+	assert.Equal(t, eventTime.Unix(), vectors[0].Timestamps[0].AsTime().Unix())
+}
+
+func TestByteSliceWriterPoolReset(t *testing.T) {
+	w := getByteSliceWriter()
+	_, err := w.Write([]byte("hello"))
+	assert.Nil(t, err)
+	putByteSliceWriter(w)
+
+	assert.Equal(t, 0, len(w.buf))
+	assert.Equal(t, int64(0), w.offset)
+}